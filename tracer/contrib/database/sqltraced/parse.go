@@ -4,13 +4,20 @@ import (
 	"github.com/DataDog/dd-trace-go/tracer/contrib/database/sqltraced/parsedsn"
 )
 
-// parseDSN returns all information passed through the DSN:
-func parseDSN(driverType, dsn string) (meta map[string]string, err error) {
+// ParseDSN returns all information passed through the DSN, normalized into
+// the db.*/out.* tag names OpenTraced sets on a span.
+func ParseDSN(driverType, dsn string) (meta map[string]string, err error) {
 	switch driverType {
 	case "*pq.Driver":
 		meta, err = parsedsn.Postgres(dsn)
 	case "*mysql.MySQLDriver":
 		meta, err = parsedsn.MySQL(dsn)
+	case "*sqlite3.SQLiteDriver":
+		meta, err = parsedsn.SQLite(dsn)
+	case "*mssql.Driver":
+		meta, err = parsedsn.MSSQL(dsn)
+	case "*oci8.OCI8Driver", "*godror.drv":
+		meta, err = parsedsn.Oracle(dsn)
 	}
 	meta = normalize(meta)
 	return meta, err
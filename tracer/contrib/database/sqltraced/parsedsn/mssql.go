@@ -0,0 +1,34 @@
+package parsedsn
+
+import "strings"
+
+// MSSQL parses a mssql-type dsn, as used by denisenkom/go-mssqldb, into a
+// map. Only the ADO-style "key=value;key2=value2" form is handled; a URL
+// form dsn (sqlserver://...) is not parsed and yields an empty map.
+func MSSQL(dsn string) (map[string]string, error) {
+	meta := make(map[string]string)
+	for _, kv := range strings.Split(dsn, ";") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		p := strings.SplitN(kv, "=", 2)
+		if len(p) != 2 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(p[0]))
+		val := strings.TrimSpace(p[1])
+		switch key {
+		case "server":
+			meta["host"] = val
+		case "port":
+			meta["port"] = val
+		case "database":
+			meta["dbname"] = val
+		case "user id":
+			meta["user"] = val
+		}
+	}
+
+	return meta, nil
+}
@@ -0,0 +1,35 @@
+package parsedsn
+
+import "testing"
+
+func TestMSSQL(t *testing.T) {
+	dsn := "server=localhost;user id=sa;password=secret;port=1433;database=master"
+	meta, err := MSSQL(dsn)
+	if err != nil {
+		t.Fatalf("MSSQL(%q): %v", dsn, err)
+	}
+	want := map[string]string{
+		"host":   "localhost",
+		"user":   "sa",
+		"port":   "1433",
+		"dbname": "master",
+	}
+	for k, v := range want {
+		if meta[k] != v {
+			t.Errorf("MSSQL(%q)[%q] = %q, want %q", dsn, k, meta[k], v)
+		}
+	}
+}
+
+func TestMSSQLMissingFields(t *testing.T) {
+	meta, err := MSSQL("server=localhost;database=master")
+	if err != nil {
+		t.Fatalf("MSSQL: %v", err)
+	}
+	if _, ok := meta["user"]; ok {
+		t.Errorf("expected no user key when user id is absent, got %q", meta["user"])
+	}
+	if meta["host"] != "localhost" || meta["dbname"] != "master" {
+		t.Errorf("unexpected meta: %#v", meta)
+	}
+}
@@ -0,0 +1,30 @@
+package parsedsn
+
+import "regexp"
+
+// oracleDSN matches the "user/password@host:port/service_name" connection
+// string accepted by mattn/go-oci8 and godror. The password and service
+// name segments may themselves contain slashes, so the host:port segment
+// is what anchors the match.
+var oracleDSN = regexp.MustCompile(`^(?:([^/@]+)/[^@]*@)?([^:@/]+)(?::(\d+))?/(.+)$`)
+
+// Oracle parses an oracle-type dsn, as used by mattn/go-oci8 and godror,
+// into a map.
+func Oracle(dsn string) (map[string]string, error) {
+	m := oracleDSN.FindStringSubmatch(dsn)
+	if m == nil {
+		return nil, nil
+	}
+
+	meta := make(map[string]string)
+	if m[1] != "" {
+		meta["user"] = m[1]
+	}
+	meta["host"] = m[2]
+	if m[3] != "" {
+		meta["port"] = m[3]
+	}
+	meta["dbname"] = m[4]
+
+	return meta, nil
+}
@@ -0,0 +1,48 @@
+package parsedsn
+
+import "testing"
+
+func TestOracle(t *testing.T) {
+	for _, tt := range []struct {
+		dsn  string
+		want map[string]string
+	}{
+		{
+			dsn:  "scott/tiger@localhost:1521/orcl",
+			want: map[string]string{"user": "scott", "host": "localhost", "port": "1521", "dbname": "orcl"},
+		},
+		{
+			dsn:  "localhost/orcl",
+			want: map[string]string{"host": "localhost", "dbname": "orcl"},
+		},
+		{
+			dsn:  "scott/tiger@localhost/orcl",
+			want: map[string]string{"user": "scott", "host": "localhost", "dbname": "orcl"},
+		},
+	} {
+		meta, err := Oracle(tt.dsn)
+		if err != nil {
+			t.Fatalf("Oracle(%q): %v", tt.dsn, err)
+		}
+		for k, v := range tt.want {
+			if meta[k] != v {
+				t.Errorf("Oracle(%q)[%q] = %q, want %q", tt.dsn, k, meta[k], v)
+			}
+		}
+		if _, wantPort := tt.want["port"]; !wantPort {
+			if port, present := meta["port"]; present {
+				t.Errorf("Oracle(%q) unexpected port %q", tt.dsn, port)
+			}
+		}
+	}
+}
+
+func TestOracleNoMatch(t *testing.T) {
+	meta, err := Oracle("")
+	if err != nil {
+		t.Fatalf("Oracle(\"\"): %v", err)
+	}
+	if meta != nil {
+		t.Errorf("Oracle(\"\") meta = %#v, want nil", meta)
+	}
+}
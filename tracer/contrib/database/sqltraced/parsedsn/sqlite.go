@@ -0,0 +1,27 @@
+package parsedsn
+
+import (
+	"net/url"
+	"strings"
+)
+
+// SQLite parses a sqlite-type dsn, as used by mattn/go-sqlite3, into a map.
+// The dsn is simply a file path (optionally a "file:" URI) followed by
+// query parameters, so there is no user, host or port to report; host is
+// hardcoded to "localhost" so callers still get an out.host tag.
+func SQLite(dsn string) (map[string]string, error) {
+	path := dsn
+	if u, err := url.Parse(dsn); err == nil && u.Scheme == "file" {
+		path = u.Opaque
+		if path == "" {
+			path = u.Path
+		}
+	} else if i := strings.IndexByte(dsn, '?'); i >= 0 {
+		path = dsn[:i]
+	}
+
+	return map[string]string{
+		"dbname": path,
+		"host":   "localhost",
+	}, nil
+}
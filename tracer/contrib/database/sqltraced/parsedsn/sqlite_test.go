@@ -0,0 +1,24 @@
+package parsedsn
+
+import "testing"
+
+func TestSQLite(t *testing.T) {
+	for _, tt := range []struct {
+		dsn, dbname string
+	}{
+		{"/tmp/test.db", "/tmp/test.db"},
+		{"file:/tmp/test.db?cache=shared&mode=memory", "/tmp/test.db"},
+		{":memory:", ":memory:"},
+	} {
+		meta, err := SQLite(tt.dsn)
+		if err != nil {
+			t.Fatalf("SQLite(%q): %v", tt.dsn, err)
+		}
+		if meta["dbname"] != tt.dbname {
+			t.Errorf("SQLite(%q) dbname = %q, want %q", tt.dsn, meta["dbname"], tt.dbname)
+		}
+		if meta["host"] != "localhost" {
+			t.Errorf("SQLite(%q) host = %q, want %q", tt.dsn, meta["host"], "localhost")
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Package http lets HTTP clients and servers configure how dd-trace-go
+// propagates distributed tracing context over request headers.
+package http
+
+import (
+	"net/http"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+// Config controls which Propagators a traced HTTP client or server uses to
+// inject trace context into outgoing requests and extract it from incoming
+// ones.
+type Config struct {
+	// Injectors are applied, in order, to every outgoing request.
+	Injectors []tracer.Propagator
+	// Extractors are tried, in order, against an incoming request's
+	// headers; the first one that recognizes them wins.
+	Extractors []tracer.Propagator
+}
+
+// Option configures a Config.
+type Option func(*Config)
+
+// NewConfig builds a Config from opts. Unless overridden, it injects and
+// extracts Datadog's own headers only, preserving prior behavior.
+func NewConfig(opts ...Option) *Config {
+	cfg := &Config{
+		Injectors:  []tracer.Propagator{tracer.DatadogPropagator{}},
+		Extractors: []tracer.Propagator{tracer.DatadogPropagator{}},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithPropagators replaces both the injectors and extractors with ps.
+func WithPropagators(ps ...tracer.Propagator) Option {
+	return func(cfg *Config) {
+		cfg.Injectors = ps
+		cfg.Extractors = ps
+	}
+}
+
+// WithInjectors replaces the propagators used to write trace context into
+// outgoing request headers.
+func WithInjectors(ps ...tracer.Propagator) Option {
+	return func(cfg *Config) { cfg.Injectors = ps }
+}
+
+// WithExtractors replaces the propagators tried, in order, to read a trace
+// context from incoming request headers. This is how a service accepts,
+// say, both Datadog's and W3C's formats without picking just one.
+func WithExtractors(ps ...tracer.Propagator) Option {
+	return func(cfg *Config) { cfg.Extractors = ps }
+}
+
+// Inject writes span's trace context into headers using cfg's injectors.
+func (cfg *Config) Inject(span *tracer.Span, headers http.Header) error {
+	return tracer.Inject(span, headers, cfg.Injectors...)
+}
+
+// Extract reads a trace context from headers using cfg's extractors, in
+// order, returning the first one that matches.
+func (cfg *Config) Extract(headers http.Header) (tracer.SpanContext, error) {
+	return tracer.Extract(headers, cfg.Extractors...)
+}
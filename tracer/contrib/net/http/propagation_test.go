@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+func TestConfigDefaultsToDatadog(t *testing.T) {
+	cfg := NewConfig()
+	span := &tracer.Span{TraceID: 42, SpanID: 100}
+	headers := http.Header{}
+
+	if err := cfg.Inject(span, headers); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+	if headers.Get("X-Datadog-Trace-Id") == "" {
+		t.Error("expected Datadog headers to be injected by default")
+	}
+	if headers.Get("Traceparent") != "" {
+		t.Error("expected no traceparent header by default")
+	}
+}
+
+func TestConfigWithExtractors(t *testing.T) {
+	cfg := NewConfig(WithExtractors(tracer.W3CPropagator{}, tracer.DatadogPropagator{}))
+	headers := http.Header{}
+	headers.Set("Traceparent", "00-0000000000000000000000000000002a-0000000000000064-01")
+
+	sctx, err := cfg.Extract(headers)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if sctx.TraceID != 42 || sctx.SpanID != 100 {
+		t.Errorf("got %+v, want TraceID=42 SpanID=100", sctx)
+	}
+}
@@ -0,0 +1,179 @@
+package sqltraced
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+// TracedConn wraps a driver.Conn so every operation performed on it is
+// recorded as a span tagged with service and meta.
+type TracedConn struct {
+	driver.Conn
+	tracer  *tracer.Tracer
+	service string
+	cfg     *config
+	meta    map[string]string
+}
+
+func (c *TracedConn) newSpan(ctx context.Context, resource string) *tracer.Span {
+	span := c.tracer.NewChildSpanFromContext("sql.query", ctx)
+	span.Service = c.service
+	span.Type = "sql"
+	span.Resource = resource
+	for k, v := range c.meta {
+		span.SetMeta(k, v)
+	}
+	return span
+}
+
+// Ping implements driver.Pinger.
+func (c *TracedConn) Ping(ctx context.Context) error {
+	span := c.newSpan(ctx, "Ping")
+	defer span.Finish()
+
+	pinger, ok := c.Conn.(driver.Pinger)
+	if !ok {
+		return nil
+	}
+	if err := pinger.Ping(ctx); err != nil {
+		span.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// Prepare implements driver.Conn.
+func (c *TracedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.prepare(context.Background(), query)
+}
+
+// PrepareContext implements driver.ConnPrepareContext, so a prepare made
+// through e.g. db.PrepareContext parents its span under ctx's span instead
+// of starting a new trace.
+func (c *TracedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.prepare(ctx, query)
+}
+
+func (c *TracedConn) prepare(ctx context.Context, query string) (driver.Stmt, error) {
+	span := c.newSpan(ctx, "")
+	c.setQuerySpan(span, query)
+	defer span.Finish()
+
+	stmt, err := c.Conn.Prepare(query)
+	if err != nil {
+		span.SetError(err)
+		return nil, err
+	}
+	return &TracedStmt{
+		Stmt:    stmt,
+		tracer:  c.tracer,
+		service: c.service,
+		cfg:     c.cfg,
+		meta:    c.meta,
+		query:   query,
+	}, nil
+}
+
+// Exec implements driver.Execer.
+func (c *TracedConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return c.exec(context.Background(), query, args)
+}
+
+// ExecContext implements driver.ExecerContext.
+func (c *TracedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.exec(ctx, query, namedValuesToValues(args))
+}
+
+func (c *TracedConn) exec(ctx context.Context, query string, args []driver.Value) (driver.Result, error) {
+	span := c.newSpan(ctx, "")
+	c.setQuerySpan(span, query)
+	defer span.Finish()
+
+	execer, ok := c.Conn.(driver.Execer)
+	if !ok {
+		err := driver.ErrSkip
+		span.SetError(err)
+		return nil, err
+	}
+	res, err := execer.Exec(query, args)
+	if err != nil {
+		span.SetError(err)
+	}
+	return res, err
+}
+
+// Query implements driver.Queryer.
+func (c *TracedConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return c.query(context.Background(), query, args)
+}
+
+// QueryContext implements driver.QueryerContext.
+func (c *TracedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return c.query(ctx, query, namedValuesToValues(args))
+}
+
+func (c *TracedConn) query(ctx context.Context, query string, args []driver.Value) (driver.Rows, error) {
+	span := c.newSpan(ctx, "")
+	c.setQuerySpan(span, query)
+	defer span.Finish()
+
+	queryer, ok := c.Conn.(driver.Queryer)
+	if !ok {
+		err := driver.ErrSkip
+		span.SetError(err)
+		return nil, err
+	}
+	rows, err := queryer.Query(query, args)
+	if err != nil {
+		span.SetError(err)
+	}
+	return rows, err
+}
+
+// Begin implements driver.Conn.
+func (c *TracedConn) Begin() (driver.Tx, error) {
+	return c.beginTx(context.Background())
+}
+
+// BeginTx implements driver.ConnBeginTx.
+func (c *TracedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.beginTx(ctx)
+}
+
+func (c *TracedConn) beginTx(ctx context.Context) (driver.Tx, error) {
+	span := c.newSpan(ctx, "Begin")
+	defer span.Finish()
+
+	tx, err := c.Conn.Begin()
+	if err != nil {
+		span.SetError(err)
+		return nil, err
+	}
+	return &TracedTx{
+		Tx:      tx,
+		tracer:  c.tracer,
+		service: c.service,
+		meta:    c.meta,
+		ctx:     ctx,
+	}, nil
+}
+
+// setQuerySpan sets span's resource and, unless c.cfg.mode is
+// QueryModeDisabled, its sql.query tag for query.
+func (c *TracedConn) setQuerySpan(span *tracer.Span, query string) {
+	resource, setQueryTag := queryResource(query, c.cfg.mode)
+	span.Resource = resource
+	if setQueryTag {
+		span.SetMeta("sql.query", query)
+	}
+}
+
+func namedValuesToValues(named []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(named))
+	for i, n := range named {
+		values[i] = n.Value
+	}
+	return values
+}
@@ -0,0 +1,27 @@
+package sqltraced
+
+import (
+	"testing"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+func TestMSSQL(t *testing.T) {
+	dsn := "server=localhost;user id=sa;password=DdTrace123!;port=1433;database=master"
+	db := newDB("mssql", "mssql-test", &mssql.Driver{}, dsn)
+	defer db.Close()
+
+	expectedSpan := &tracer.Span{
+		Name:    "sql.query",
+		Service: "mssql-test",
+		Type:    "sql",
+	}
+	expectedSpan.SetMeta("out.host", "localhost")
+	expectedSpan.SetMeta("out.port", "1433")
+	expectedSpan.SetMeta("db.name", "master")
+	expectedSpan.SetMeta("db.user", "sa")
+
+	AllSQLTests(t, db, expectedSpan)
+}
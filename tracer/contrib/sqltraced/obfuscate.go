@@ -0,0 +1,86 @@
+package sqltraced
+
+import (
+	"regexp"
+	"strings"
+)
+
+// QueryMode controls how OpenTraced records the query text of a span.
+type QueryMode int
+
+const (
+	// QueryModeObfuscate strips literals from the query before using it
+	// as the span's resource, collapsing high-cardinality queries like
+	// "... VALUES('New York')" and "... VALUES('Boston')" down to the
+	// same resource. This is the default. The sql.query tag still holds
+	// the query as executed, literals included.
+	QueryModeObfuscate QueryMode = iota
+	// QueryModeRaw records the query exactly as executed, literals
+	// included. Only enable this if the query text is known not to
+	// carry PII or secrets, since it becomes the span's resource name.
+	QueryModeRaw
+	// QueryModeDisabled omits the sql.query tag; the resource is still set
+	// to the obfuscated query, same as QueryModeObfuscate, so spans never
+	// end up with an empty resource.
+	QueryModeDisabled
+)
+
+var (
+	literalPattern    = regexp.MustCompile(`'(?:[^']|'')*'|"(?:[^"]|"")*"|\b\d+\b`)
+	inListPattern     = regexp.MustCompile(`(?i)\bIN\s*\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// obfuscate strips string and numeric literals from query, replacing them
+// with "?", collapses "IN (?, ?, ...)" lists down to "IN (?)", and
+// normalizes whitespace. It is the resource dd-trace-go reports for a span
+// when QueryMode is QueryModeObfuscate.
+func obfuscate(query string) string {
+	q := literalPattern.ReplaceAllString(query, "?")
+	q = inListPattern.ReplaceAllString(q, "IN (?)")
+	q = whitespacePattern.ReplaceAllString(q, " ")
+	return strings.TrimSpace(q)
+}
+
+// queryResource returns the resource to set on a span for query under
+// mode, and whether the sql.query tag should also be set. The resource is
+// never empty: QueryModeDisabled still reports the obfuscated query, it
+// just withholds the sql.query tag.
+func queryResource(query string, mode QueryMode) (resource string, setQueryTag bool) {
+	switch mode {
+	case QueryModeRaw:
+		return query, true
+	case QueryModeDisabled:
+		return obfuscate(query), false
+	default:
+		return obfuscate(query), true
+	}
+}
+
+// Option configures the way OpenTraced records queries.
+type Option func(*config)
+
+type config struct {
+	mode QueryMode
+}
+
+func newConfig(opts ...Option) *config {
+	cfg := &config{mode: QueryModeObfuscate}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithQueryMode sets the QueryMode used when recording queries.
+func WithQueryMode(mode QueryMode) Option {
+	return func(cfg *config) { cfg.mode = mode }
+}
+
+// WithRawQuery is a shorthand for WithQueryMode(QueryModeRaw): it makes
+// OpenTraced record the raw, non-obfuscated query as the span's resource.
+// Without it the resource is obfuscated, but the sql.query tag still holds
+// the raw query unless QueryModeDisabled is selected.
+func WithRawQuery() Option {
+	return WithQueryMode(QueryModeRaw)
+}
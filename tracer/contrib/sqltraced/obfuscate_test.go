@@ -0,0 +1,37 @@
+package sqltraced
+
+import "testing"
+
+func TestObfuscate(t *testing.T) {
+	tests := []struct{ query, want string }{
+		{
+			"INSERT INTO city(name) VALUES('New York')",
+			"INSERT INTO city(name) VALUES(?)",
+		},
+		{
+			"SELECT * FROM city WHERE id = 42",
+			"SELECT * FROM city WHERE id = ?",
+		},
+		{
+			"SELECT * FROM city WHERE id IN (1,2,3)",
+			"SELECT * FROM city WHERE id IN (?)",
+		},
+		{
+			"SELECT * FROM city WHERE id IN (?, ?, ?)",
+			"SELECT * FROM city WHERE id IN (?)",
+		},
+		{
+			"SELECT   *  FROM   city",
+			"SELECT * FROM city",
+		},
+		{
+			`SELECT * FROM city WHERE name = "New York"`,
+			"SELECT * FROM city WHERE name = ?",
+		},
+	}
+	for _, tt := range tests {
+		if got := obfuscate(tt.query); got != tt.want {
+			t.Errorf("obfuscate(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
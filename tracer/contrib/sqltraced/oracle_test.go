@@ -0,0 +1,27 @@
+package sqltraced
+
+import (
+	"testing"
+
+	oci8 "github.com/mattn/go-oci8"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+func TestOracle(t *testing.T) {
+	dsn := "system/oracle@localhost:1521/xe"
+	db := newDB("oracle", "oracle-test", &oci8.OCI8Driver{}, dsn)
+	defer db.Close()
+
+	expectedSpan := &tracer.Span{
+		Name:    "sql.query",
+		Service: "oracle-test",
+		Type:    "sql",
+	}
+	expectedSpan.SetMeta("out.host", "localhost")
+	expectedSpan.SetMeta("out.port", "1521")
+	expectedSpan.SetMeta("db.name", "xe")
+	expectedSpan.SetMeta("db.user", "system")
+
+	AllSQLTests(t, db, expectedSpan)
+}
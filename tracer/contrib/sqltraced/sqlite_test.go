@@ -0,0 +1,24 @@
+package sqltraced
+
+import (
+	"testing"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+func TestSqlite(t *testing.T) {
+	db := newDB("sqlite3", "sqlite-test", &sqlite3.SQLiteDriver{}, ":memory:")
+	defer db.Close()
+
+	expectedSpan := &tracer.Span{
+		Name:    "sql.query",
+		Service: "sqlite-test",
+		Type:    "sql",
+	}
+	expectedSpan.SetMeta("out.host", "localhost")
+	expectedSpan.SetMeta("db.name", ":memory:")
+
+	AllSQLTests(t, db, expectedSpan)
+}
@@ -0,0 +1,68 @@
+package sqltraced
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+	dsnparse "github.com/DataDog/dd-trace-go/tracer/contrib/database/sqltraced"
+)
+
+var (
+	registerMu  sync.Mutex
+	registerSeq int
+)
+
+// OpenTraced registers a traced version of driver and opens dsn through it,
+// so every ping, prepared statement, query and transaction run against the
+// resulting *sql.DB produces a span tagged with service and with the
+// out.host/out.port/db.name/db.user tags ParseDSN extracts from dsn. By
+// default the span's resource is the obfuscated query; pass WithRawQuery
+// to report it verbatim instead.
+func OpenTraced(driver driver.Driver, dsn, service string, trc *tracer.Tracer, opts ...Option) (*sql.DB, error) {
+	meta, err := dsnparse.ParseDSN(fmt.Sprintf("%T", driver), dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	registerMu.Lock()
+	registerSeq++
+	name := fmt.Sprintf("dd-trace-%d", registerSeq)
+	registerMu.Unlock()
+
+	sql.Register(name, &TracedDriver{
+		Driver:  driver,
+		tracer:  trc,
+		service: service,
+		cfg:     newConfig(opts...),
+		meta:    meta,
+	})
+
+	return sql.Open(name, dsn)
+}
+
+// TracedDriver wraps a driver.Driver so every connection it opens is traced.
+type TracedDriver struct {
+	driver.Driver
+	tracer  *tracer.Tracer
+	service string
+	cfg     *config
+	meta    map[string]string
+}
+
+// Open implements driver.Driver.
+func (d *TracedDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &TracedConn{
+		Conn:    conn,
+		tracer:  d.tracer,
+		service: d.service,
+		cfg:     d.cfg,
+		meta:    d.meta,
+	}, nil
+}
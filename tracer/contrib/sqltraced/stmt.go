@@ -0,0 +1,76 @@
+package sqltraced
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+// TracedStmt wraps a driver.Stmt so every execution of the prepared query
+// is recorded as a span.
+type TracedStmt struct {
+	driver.Stmt
+	tracer  *tracer.Tracer
+	service string
+	cfg     *config
+	meta    map[string]string
+	query   string
+}
+
+func (s *TracedStmt) newSpan(ctx context.Context) *tracer.Span {
+	span := s.tracer.NewChildSpanFromContext("sql.query", ctx)
+	span.Service = s.service
+	span.Type = "sql"
+	for k, v := range s.meta {
+		span.SetMeta(k, v)
+	}
+	resource, setQueryTag := queryResource(s.query, s.cfg.mode)
+	span.Resource = resource
+	if setQueryTag {
+		span.SetMeta("sql.query", s.query)
+	}
+	return span
+}
+
+// Exec implements driver.Stmt.
+func (s *TracedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.exec(context.Background(), args)
+}
+
+// ExecContext implements driver.StmtExecContext.
+func (s *TracedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.exec(ctx, namedValuesToValues(args))
+}
+
+func (s *TracedStmt) exec(ctx context.Context, args []driver.Value) (driver.Result, error) {
+	span := s.newSpan(ctx)
+	defer span.Finish()
+
+	res, err := s.Stmt.Exec(args)
+	if err != nil {
+		span.SetError(err)
+	}
+	return res, err
+}
+
+// Query implements driver.Stmt.
+func (s *TracedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.queryRows(context.Background(), args)
+}
+
+// QueryContext implements driver.StmtQueryContext.
+func (s *TracedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.queryRows(ctx, namedValuesToValues(args))
+}
+
+func (s *TracedStmt) queryRows(ctx context.Context, args []driver.Value) (driver.Rows, error) {
+	span := s.newSpan(ctx)
+	defer span.Finish()
+
+	rows, err := s.Stmt.Query(args)
+	if err != nil {
+		span.SetError(err)
+	}
+	return rows, err
+}
@@ -54,7 +54,7 @@ func testDB(t *testing.T, db *DB, expectedSpan *tracer.Span) {
 
 	actualSpan = spans[0]
 	querySpan := tracer.CopySpan(expectedSpan, db.Tracer)
-	querySpan.Resource = query
+	querySpan.Resource = obfuscate(query)
 	querySpan.SetMeta("sql.query", query)
 	tracer.CompareSpan(t, querySpan, actualSpan)
 	delete(expectedSpan.Meta, "sql.query")
@@ -66,8 +66,12 @@ func testStatement(t *testing.T, db *DB, expectedSpan *tracer.Span) {
 	switch strings.ToLower(db.Name) {
 	case "postgres":
 		query = fmt.Sprintf(query, "$1")
-	case "mysql":
+	case "mysql", "sqlite3":
 		query = fmt.Sprintf(query, "?")
+	case "mssql":
+		query = fmt.Sprintf(query, "@p1")
+	case "oracle":
+		query = fmt.Sprintf(query, ":1")
 	}
 
 	// Test TracedConn.PrepareContext
@@ -82,7 +86,7 @@ func testStatement(t *testing.T, db *DB, expectedSpan *tracer.Span) {
 
 	actualSpan := spans[0]
 	prepareSpan := tracer.CopySpan(expectedSpan, db.Tracer)
-	prepareSpan.Resource = query
+	prepareSpan.Resource = obfuscate(query)
 	prepareSpan.SetMeta("sql.query", query)
 	tracer.CompareSpan(t, prepareSpan, actualSpan)
 	delete(expectedSpan.Meta, "sql.query")
@@ -99,7 +103,7 @@ func testStatement(t *testing.T, db *DB, expectedSpan *tracer.Span) {
 	actualSpan = spans[0]
 
 	execSpan := tracer.CopySpan(expectedSpan, db.Tracer)
-	execSpan.Resource = query
+	execSpan.Resource = obfuscate(query)
 	execSpan.SetMeta("sql.query", query)
 	tracer.CompareSpan(t, execSpan, actualSpan)
 	delete(expectedSpan.Meta, "sql.query")
@@ -159,7 +163,7 @@ func testTransaction(t *testing.T, db *DB, expectedSpan *tracer.Span) {
 
 	actualSpan = spans[1]
 	execSpan := tracer.CopySpan(expectedSpan, db.Tracer)
-	execSpan.Resource = query
+	execSpan.Resource = obfuscate(query)
 	execSpan.SetMeta("sql.query", query)
 	tracer.CompareSpan(t, execSpan, actualSpan)
 	delete(expectedSpan.Meta, "sql.query")
@@ -0,0 +1,53 @@
+package sqltraced
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/DataDog/dd-trace-go/tracer"
+)
+
+// TracedTx wraps a driver.Tx so Commit and Rollback are recorded as spans,
+// parented under the context the transaction was started with.
+type TracedTx struct {
+	driver.Tx
+	tracer  *tracer.Tracer
+	service string
+	meta    map[string]string
+	ctx     context.Context
+}
+
+func (tx *TracedTx) newSpan(resource string) *tracer.Span {
+	span := tx.tracer.NewChildSpanFromContext("sql.query", tx.ctx)
+	span.Service = tx.service
+	span.Type = "sql"
+	span.Resource = resource
+	for k, v := range tx.meta {
+		span.SetMeta(k, v)
+	}
+	return span
+}
+
+// Commit implements driver.Tx.
+func (tx *TracedTx) Commit() error {
+	span := tx.newSpan("Commit")
+	defer span.Finish()
+
+	if err := tx.Tx.Commit(); err != nil {
+		span.SetError(err)
+		return err
+	}
+	return nil
+}
+
+// Rollback implements driver.Tx.
+func (tx *TracedTx) Rollback() error {
+	span := tx.newSpan("Rollback")
+	defer span.Finish()
+
+	if err := tx.Tx.Rollback(); err != nil {
+		span.SetError(err)
+		return err
+	}
+	return nil
+}
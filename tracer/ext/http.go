@@ -10,8 +10,19 @@ const (
 	HTTPURL    = "http.url"
 )
 
-// Distributed tracing headers
+// Distributed tracing headers.
+//
+// HTTPTraceIDHeader/HTTPParentIDHeader carry Datadog's own format.
+// HTTPTraceParentHeader/HTTPTraceStateHeader carry the W3C Trace Context
+// format (https://www.w3.org/TR/trace-context/). HTTPB3SingleHeader
+// carries the single-header B3 format used by Zipkin/Jaeger, kept around
+// for interop with OpenTelemetry-instrumented services.
 var (
 	HTTPTraceIDHeader  = textproto.CanonicalMIMEHeaderKey("X-Datadog-Trace-Id")
 	HTTPParentIDHeader = textproto.CanonicalMIMEHeaderKey("X-Datadog-Parent-Id")
+
+	HTTPTraceParentHeader = textproto.CanonicalMIMEHeaderKey("traceparent")
+	HTTPTraceStateHeader  = textproto.CanonicalMIMEHeaderKey("tracestate")
+
+	HTTPB3SingleHeader = textproto.CanonicalMIMEHeaderKey("b3")
 )
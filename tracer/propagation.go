@@ -0,0 +1,183 @@
+package tracer
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/dd-trace-go/tracer/ext"
+)
+
+// w3cTraceStateMeta is the span tag W3CPropagator.Inject reads a tracestate
+// value from and writes out unchanged. W3CPropagator.Extract does not set
+// it: an extracted tracestate is returned in SpanContext.State only.
+const w3cTraceStateMeta = "w3c.tracestate"
+
+// ErrSpanContextNotFound is returned by a Propagator's Extract method when
+// headers carry no trace context it recognizes.
+var ErrSpanContextNotFound = fmt.Errorf("tracer: span context not found")
+
+// SpanContext carries the state needed to continue a trace across a
+// process boundary: the IDs to parent the next span under, plus any
+// provider-specific state (e.g. W3C's tracestate) that must be forwarded
+// as-is even though dd-trace-go doesn't interpret it.
+type SpanContext struct {
+	TraceID uint64
+	SpanID  uint64
+	State   string
+}
+
+// A Propagator injects a span's trace context into, and extracts it from,
+// the headers of an outgoing or incoming request. Implementations exist
+// for Datadog's own headers, W3C Trace Context and single-header B3, so
+// that dd-trace-go can interoperate with other tracing systems.
+type Propagator interface {
+	// Inject writes span's trace context into headers.
+	Inject(span *Span, headers http.Header) error
+
+	// Extract reads a trace context from headers. It returns
+	// ErrSpanContextNotFound if headers carry no context it recognizes.
+	Extract(headers http.Header) (SpanContext, error)
+}
+
+// Inject writes span's trace context into headers using every propagator
+// in ps, so a single outgoing request can carry more than one wire format.
+func Inject(span *Span, headers http.Header, ps ...Propagator) error {
+	for _, p := range ps {
+		if err := p.Inject(span, headers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Extract reads a trace context from headers, trying each propagator in
+// ps in order and returning the first one that finds a match.
+func Extract(headers http.Header, ps ...Propagator) (SpanContext, error) {
+	for _, p := range ps {
+		sctx, err := p.Extract(headers)
+		if err == nil {
+			return sctx, nil
+		}
+	}
+	return SpanContext{}, ErrSpanContextNotFound
+}
+
+// DatadogPropagator injects and extracts trace context using Datadog's
+// X-Datadog-Trace-Id and X-Datadog-Parent-Id headers.
+type DatadogPropagator struct{}
+
+// Inject implements Propagator.
+func (DatadogPropagator) Inject(span *Span, headers http.Header) error {
+	if span == nil {
+		return fmt.Errorf("tracer: cannot inject a nil span")
+	}
+	headers.Set(ext.HTTPTraceIDHeader, strconv.FormatUint(span.TraceID, 10))
+	headers.Set(ext.HTTPParentIDHeader, strconv.FormatUint(span.SpanID, 10))
+	return nil
+}
+
+// Extract implements Propagator.
+func (DatadogPropagator) Extract(headers http.Header) (SpanContext, error) {
+	tid := headers.Get(ext.HTTPTraceIDHeader)
+	pid := headers.Get(ext.HTTPParentIDHeader)
+	if tid == "" || pid == "" {
+		return SpanContext{}, ErrSpanContextNotFound
+	}
+	traceID, err := strconv.ParseUint(tid, 10, 64)
+	if err != nil {
+		return SpanContext{}, err
+	}
+	parentID, err := strconv.ParseUint(pid, 10, 64)
+	if err != nil {
+		return SpanContext{}, err
+	}
+	return SpanContext{TraceID: traceID, SpanID: parentID}, nil
+}
+
+// W3CPropagator injects and extracts trace context using the W3C Trace
+// Context traceparent/tracestate headers
+// (https://www.w3.org/TR/trace-context/). Since dd-trace-go's trace IDs are
+// 64-bit, they are zero-extended into the 128-bit trace-id on injection and
+// truncated back to their low 64 bits on extraction.
+// tracestate isn't interpreted: Inject writes the span's w3cTraceStateMeta
+// tag, if set, and Extract returns the header in SpanContext.State.
+type W3CPropagator struct{}
+
+// Inject implements Propagator.
+func (W3CPropagator) Inject(span *Span, headers http.Header) error {
+	if span == nil {
+		return fmt.Errorf("tracer: cannot inject a nil span")
+	}
+	flags := "00"
+	if span.Sampled {
+		flags = "01"
+	}
+	headers.Set(ext.HTTPTraceParentHeader, fmt.Sprintf("00-%016x%016x-%016x-%s", 0, span.TraceID, span.SpanID, flags))
+	if state := span.Meta[w3cTraceStateMeta]; state != "" {
+		headers.Set(ext.HTTPTraceStateHeader, state)
+	}
+	return nil
+}
+
+// Extract implements Propagator.
+func (W3CPropagator) Extract(headers http.Header) (SpanContext, error) {
+	tp := headers.Get(ext.HTTPTraceParentHeader)
+	if tp == "" {
+		return SpanContext{}, ErrSpanContextNotFound
+	}
+	parts := strings.Split(tp, "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return SpanContext{}, fmt.Errorf("tracer: malformed traceparent header: %q", tp)
+	}
+	// Take the low 64 bits of the 128-bit trace-id; dd-trace-go IDs don't widen.
+	traceID, err := strconv.ParseUint(parts[1][16:], 16, 64)
+	if err != nil {
+		return SpanContext{}, err
+	}
+	parentID, err := strconv.ParseUint(parts[2], 16, 64)
+	if err != nil {
+		return SpanContext{}, err
+	}
+	return SpanContext{TraceID: traceID, SpanID: parentID, State: headers.Get(ext.HTTPTraceStateHeader)}, nil
+}
+
+// B3Propagator injects and extracts trace context using the single-header
+// B3 format (https://github.com/openzipkin/b3-propagation), for
+// interoperability with Zipkin/Jaeger-instrumented services.
+type B3Propagator struct{}
+
+// Inject implements Propagator.
+func (B3Propagator) Inject(span *Span, headers http.Header) error {
+	if span == nil {
+		return fmt.Errorf("tracer: cannot inject a nil span")
+	}
+	sampled := "0"
+	if span.Sampled {
+		sampled = "1"
+	}
+	headers.Set(ext.HTTPB3SingleHeader, fmt.Sprintf("%016x-%016x-%s", span.TraceID, span.SpanID, sampled))
+	return nil
+}
+
+// Extract implements Propagator.
+func (B3Propagator) Extract(headers http.Header) (SpanContext, error) {
+	b3 := headers.Get(ext.HTTPB3SingleHeader)
+	if b3 == "" {
+		return SpanContext{}, ErrSpanContextNotFound
+	}
+	parts := strings.Split(b3, "-")
+	if len(parts) < 2 {
+		return SpanContext{}, fmt.Errorf("tracer: malformed b3 header: %q", b3)
+	}
+	traceID, err := strconv.ParseUint(parts[0], 16, 64)
+	if err != nil {
+		return SpanContext{}, err
+	}
+	parentID, err := strconv.ParseUint(parts[1], 16, 64)
+	if err != nil {
+		return SpanContext{}, err
+	}
+	return SpanContext{TraceID: traceID, SpanID: parentID}, nil
+}
@@ -0,0 +1,127 @@
+package tracer
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestDatadogPropagatorRoundTrip(t *testing.T) {
+	span := &Span{TraceID: 42, SpanID: 100}
+	headers := http.Header{}
+
+	if err := (DatadogPropagator{}).Inject(span, headers); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+
+	sctx, err := (DatadogPropagator{}).Extract(headers)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if sctx.TraceID != span.TraceID || sctx.SpanID != span.SpanID {
+		t.Errorf("got %+v, want TraceID=%d SpanID=%d", sctx, span.TraceID, span.SpanID)
+	}
+}
+
+func TestDatadogPropagatorExtractMissing(t *testing.T) {
+	if _, err := (DatadogPropagator{}).Extract(http.Header{}); err != ErrSpanContextNotFound {
+		t.Errorf("err = %v, want ErrSpanContextNotFound", err)
+	}
+}
+
+func TestW3CPropagatorRoundTrip(t *testing.T) {
+	span := &Span{TraceID: 42, SpanID: 100, Sampled: true, Meta: map[string]string{
+		w3cTraceStateMeta: "dd=s:1",
+	}}
+	headers := http.Header{}
+
+	if err := (W3CPropagator{}).Inject(span, headers); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+
+	wantTraceParent := "00-0000000000000000000000000000002a-0000000000000064-01"
+	if got := headers.Get("Traceparent"); got != wantTraceParent {
+		t.Errorf("traceparent = %q, want %q", got, wantTraceParent)
+	}
+	if got := headers.Get("Tracestate"); got != "dd=s:1" {
+		t.Errorf("tracestate = %q, want %q", got, "dd=s:1")
+	}
+
+	sctx, err := (W3CPropagator{}).Extract(headers)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if sctx.TraceID != span.TraceID || sctx.SpanID != span.SpanID {
+		t.Errorf("got %+v, want TraceID=%d SpanID=%d", sctx, span.TraceID, span.SpanID)
+	}
+	if sctx.State != "dd=s:1" {
+		t.Errorf("State = %q, want %q", sctx.State, "dd=s:1")
+	}
+}
+
+func TestW3CPropagatorWidening(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Traceparent", "00-000000000000000000000000000000ff-00000000000000ff-01")
+
+	sctx, err := (W3CPropagator{}).Extract(headers)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if sctx.TraceID != 0xff || sctx.SpanID != 0xff {
+		t.Errorf("got TraceID=%x SpanID=%x, want 0xff, 0xff", sctx.TraceID, sctx.SpanID)
+	}
+}
+
+func TestW3CPropagatorExtractMalformed(t *testing.T) {
+	for _, tp := range []string{
+		"",
+		"garbage",
+		"00-tooshort-00000000000000ff-01",
+		"00-000000000000000000000000000000ff-tooshort-01",
+	} {
+		headers := http.Header{}
+		if tp != "" {
+			headers.Set("Traceparent", tp)
+		}
+		if _, err := (W3CPropagator{}).Extract(headers); err == nil {
+			t.Errorf("Extract(%q): expected error, got nil", tp)
+		}
+	}
+}
+
+func TestB3PropagatorRoundTrip(t *testing.T) {
+	span := &Span{TraceID: 42, SpanID: 100, Sampled: true}
+	headers := http.Header{}
+
+	if err := (B3Propagator{}).Inject(span, headers); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+
+	sctx, err := (B3Propagator{}).Extract(headers)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if sctx.TraceID != span.TraceID || sctx.SpanID != span.SpanID {
+		t.Errorf("got %+v, want TraceID=%d SpanID=%d", sctx, span.TraceID, span.SpanID)
+	}
+}
+
+func TestB3PropagatorExtractMalformed(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("B3", "not-a-valid-header")
+	if _, err := (B3Propagator{}).Extract(headers); err == nil {
+		t.Error("expected error for malformed b3 header")
+	}
+}
+
+func TestExtractTriesEachPropagatorInOrder(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("B3", "2a-64-1")
+
+	sctx, err := Extract(headers, DatadogPropagator{}, W3CPropagator{}, B3Propagator{})
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if sctx.TraceID != 42 || sctx.SpanID != 100 {
+		t.Errorf("got %+v, want TraceID=42 SpanID=100", sctx)
+	}
+}